@@ -0,0 +1,208 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// apiEndpoint is the default base URL for the PagerDuty REST API.
+const apiEndpoint = "https://api.pagerduty.com"
+
+// APIObject represents the generic fields shared by most PagerDuty domain
+// objects embedded in API responses.
+type APIObject struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Summary string `json:"summary,omitempty"`
+	Self    string `json:"self,omitempty"`
+	HTMLURL string `json:"html_url,omitempty"`
+}
+
+// APIListObject are the fields used to control pagination when listing objects.
+type APIListObject struct {
+	Limit  uint `url:"limit,omitempty" json:"limit,omitempty"`
+	Offset uint `url:"offset,omitempty" json:"offset,omitempty"`
+	More   bool `json:"more,omitempty"`
+	Total  uint `json:"total,omitempty"`
+}
+
+// APIReference are the fields required to reference another PagerDuty object.
+type APIReference struct {
+	ID   string `json:"id,omitempty"`
+	Type string `json:"type,omitempty"`
+}
+
+// APIDetails are the fields required to reference the details of another PagerDuty object.
+type APIDetails struct {
+	Type    string `json:"type,omitempty"`
+	Details string `json:"details,omitempty"`
+}
+
+// LogEntry is a list of exposed properties of an incident's log entry.
+type LogEntry struct {
+	APIObject
+	CreatedAt string `json:"created_at,omitempty"`
+}
+
+// Client wraps http.Client to provide the PagerDuty REST API.
+type Client struct {
+	authToken   string
+	authType    string
+	apiEndpoint string
+	HTTPClient  *http.Client
+}
+
+// NewClient creates a Client authenticating with a PagerDuty API token.
+func NewClient(authToken string) *Client {
+	return &Client{
+		authToken:   authToken,
+		authType:    "token",
+		apiEndpoint: apiEndpoint,
+		HTTPClient:  &http.Client{},
+	}
+}
+
+// APIError is returned for any PagerDuty API response outside the 2xx range.
+type APIError struct {
+	StatusCode int
+	RetryAfter time.Duration
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	if e.Message != "" {
+		return fmt.Sprintf("pagerduty: %s (status %d)", e.Message, e.StatusCode)
+	}
+	return fmt.Sprintf("pagerduty: request failed with status %d", e.StatusCode)
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+	return http.DefaultClient
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, headers *map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.apiEndpoint+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.pagerduty+json;version=2")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.authType == "oauth" {
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	} else {
+		req.Header.Set("Authorization", "Token token="+c.authToken)
+	}
+	if headers != nil {
+		for k, v := range *headers {
+			req.Header.Set(k, v)
+		}
+	}
+
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return nil, err
+	}
+	return checkResponse(resp)
+}
+
+// checkResponse turns any non-2xx response into an *APIError, consuming and
+// closing resp.Body in the process. On success it returns resp unchanged,
+// with resp.Body still open for the caller to decode.
+func checkResponse(resp *http.Response) (*http.Response, error) {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	if ra := resp.Header.Get("Retry-After"); ra != "" {
+		apiErr.RetryAfter = parseRetryAfter(ra)
+	}
+
+	data, _ := io.ReadAll(resp.Body)
+	var body struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if len(data) > 0 && json.Unmarshal(data, &body) == nil {
+		apiErr.Message = body.Error.Message
+	}
+	return nil, apiErr
+}
+
+// parseRetryAfter parses a Retry-After header value (either a number of
+// seconds or an HTTP date, per RFC 7231) into a duration, returning 0 if the
+// header is unparseable.
+func parseRetryAfter(header string) time.Duration {
+	if secs, err := strconv.Atoi(header); err == nil {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+func encodeBody(payload interface{}) (io.Reader, error) {
+	if payload == nil {
+		return nil, nil
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(payload); err != nil {
+		return nil, err
+	}
+	return &buf, nil
+}
+
+func (c *Client) get(path string) (*http.Response, error) {
+	return c.getContext(context.Background(), path)
+}
+
+func (c *Client) getContext(ctx context.Context, path string) (*http.Response, error) {
+	return c.do(ctx, http.MethodGet, path, nil, nil)
+}
+
+func (c *Client) post(path string, payload interface{}, headers *map[string]string) (*http.Response, error) {
+	return c.postContext(context.Background(), path, payload, headers)
+}
+
+func (c *Client) postContext(ctx context.Context, path string, payload interface{}, headers *map[string]string) (*http.Response, error) {
+	body, err := encodeBody(payload)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, http.MethodPost, path, body, headers)
+}
+
+func (c *Client) put(path string, payload interface{}, headers *map[string]string) (*http.Response, error) {
+	return c.putContext(context.Background(), path, payload, headers)
+}
+
+func (c *Client) putContext(ctx context.Context, path string, payload interface{}, headers *map[string]string) (*http.Response, error) {
+	body, err := encodeBody(payload)
+	if err != nil {
+		return nil, err
+	}
+	return c.do(ctx, http.MethodPut, path, body, headers)
+}
+
+// decodeJSON decodes resp's JSON body into payload, closing resp.Body
+// afterwards.
+func (c *Client) decodeJSON(resp *http.Response, payload interface{}) error {
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(payload)
+}
@@ -1,6 +1,7 @@
 package pagerduty
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -71,11 +72,17 @@ type ListIncidentsOptions struct {
 
 // ListIncidents lists existing incidents.
 func (c *Client) ListIncidents(o ListIncidentsOptions) (*ListIncidentsResponse, error) {
+	return c.ListIncidentsContext(context.Background(), o)
+}
+
+// ListIncidentsContext lists existing incidents, observing the cancellation
+// and deadline of ctx.
+func (c *Client) ListIncidentsContext(ctx context.Context, o ListIncidentsOptions) (*ListIncidentsResponse, error) {
 	v, err := query.Values(o)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.get("/incidents?" + v.Encode())
+	resp, err := c.getContext(ctx, "/incidents?"+v.Encode())
 	if err != nil {
 		return nil, err
 	}
@@ -90,7 +97,7 @@ type CreateIncident struct {
 
 // createIncidentResponse is returned from the API when creating a response.
 type createIncidentResponse struct {
-	Incident Incident `json:incident`
+	Incident Incident `json:"incident"`
 }
 
 // CreateIncidentOptions is the structure used when POSTing to the CreateIncident API endpoint.
@@ -106,9 +113,16 @@ type CreateIncidentOptions struct {
 
 // CreateIncident creates an incident synchronously without a corresponding event from a monitoring service.
 func (c *Client) CreateIncident(from string, i *CreateIncident) (*Incident, error) {
+	return c.CreateIncidentContext(context.Background(), from, i)
+}
+
+// CreateIncidentContext creates an incident synchronously without a
+// corresponding event from a monitoring service, observing the cancellation
+// and deadline of ctx.
+func (c *Client) CreateIncidentContext(ctx context.Context, from string, i *CreateIncident) (*Incident, error) {
 	headers := make(map[string]string)
 	headers["From"] = from
-	resp, e := c.post("/incidents", i, &headers)
+	resp, e := c.postContext(ctx, "/incidents", i, &headers)
 	if e != nil {
 		return nil, e
 	}
@@ -124,17 +138,34 @@ func (c *Client) CreateIncident(from string, i *CreateIncident) (*Incident, erro
 
 // ManageIncidents acknowledges, resolves, escalates, or reassigns one or more incidents.
 func (c *Client) ManageIncidents(from string, incidents []Incident) error {
-	r := make(map[string][]Incident)
-	headers := make(map[string]string)
-	headers["From"] = from
-	r["incidents"] = incidents
-	_, e := c.put("/incidents", r, &headers)
-	return e
+	return c.ManageIncidentsContext(context.Background(), from, incidents)
+}
+
+// ManageIncidentsContext acknowledges, resolves, escalates, or reassigns one
+// or more incidents, observing the cancellation and deadline of ctx.
+//
+// It does not call the single-purpose helpers (AcknowledgeIncidents,
+// ResolveIncidents, ReassignIncidents, EscalateIncidents, SetPriority)
+// directly: those each perform one kind of change across a batch of
+// incidents, while a caller here can mix arbitrary fields per Incident in
+// one request. Instead it shares their underlying putIncidentUpdates PUT
+// plumbing. Prefer the single-purpose helpers for new code: they build the
+// minimal PUT body PagerDuty's API expects and return the incidents as
+// updated by the API instead of discarding the response.
+func (c *Client) ManageIncidentsContext(ctx context.Context, from string, incidents []Incident) error {
+	_, err := c.putIncidentUpdates(ctx, from, map[string][]Incident{"incidents": incidents})
+	return err
 }
 
 // GetIncident shows detailed information about an incident.
 func (c *Client) GetIncident(id string) (*Incident, error) {
-	resp, err := c.get("/incidents/" + id)
+	return c.GetIncidentContext(context.Background(), id)
+}
+
+// GetIncidentContext shows detailed information about an incident, observing
+// the cancellation and deadline of ctx.
+func (c *Client) GetIncidentContext(ctx context.Context, id string) (*Incident, error) {
+	resp, err := c.getContext(ctx, "/incidents/"+id)
 	if err != nil {
 		return nil, err
 	}
@@ -159,7 +190,13 @@ type IncidentNote struct {
 
 // ListIncidentNotes lists existing notes for the specified incident.
 func (c *Client) ListIncidentNotes(id string) ([]IncidentNote, error) {
-	resp, err := c.get("/incidents/" + id + "/notes")
+	return c.ListIncidentNotesContext(context.Background(), id)
+}
+
+// ListIncidentNotesContext lists existing notes for the specified incident,
+// observing the cancellation and deadline of ctx.
+func (c *Client) ListIncidentNotesContext(ctx context.Context, id string) ([]IncidentNote, error) {
+	resp, err := c.getContext(ctx, "/incidents/"+id+"/notes")
 	if err != nil {
 		return nil, err
 	}
@@ -176,21 +213,33 @@ func (c *Client) ListIncidentNotes(id string) ([]IncidentNote, error) {
 
 // CreateIncidentNote creates a new note for the specified incident.
 func (c *Client) CreateIncidentNote(id, from string, note IncidentNote) error {
+	return c.CreateIncidentNoteContext(context.Background(), id, from, note)
+}
+
+// CreateIncidentNoteContext creates a new note for the specified incident,
+// observing the cancellation and deadline of ctx.
+func (c *Client) CreateIncidentNoteContext(ctx context.Context, id, from string, note IncidentNote) error {
 	data := make(map[string]IncidentNote)
 	data["note"] = note
 	// Creating a note requires the `From` header.
 	// See https://api-reference.pagerduty.com/#!/Incidents/post_incidents_id_notes .
 	headers := make(map[string]string)
 	headers["From"] = from
-	_, err := c.post("/incidents/"+id+"/notes", data, &headers)
+	_, err := c.postContext(ctx, "/incidents/"+id+"/notes", data, &headers)
 	return err
 }
 
 // SnoozeIncident sets an incident to not alert for a specified period of time.
 func (c *Client) SnoozeIncident(id string, duration uint) error {
+	return c.SnoozeIncidentContext(context.Background(), id, duration)
+}
+
+// SnoozeIncidentContext sets an incident to not alert for a specified period
+// of time, observing the cancellation and deadline of ctx.
+func (c *Client) SnoozeIncidentContext(ctx context.Context, id string, duration uint) error {
 	data := make(map[string]uint)
 	data["duration"] = duration
-	_, err := c.post("/incidents/"+id+"/snooze", data, nil)
+	_, err := c.postContext(ctx, "/incidents/"+id+"/snooze", data, nil)
 	return err
 }
 
@@ -210,11 +259,17 @@ type ListIncidentLogEntriesOptions struct {
 
 // ListIncidentLogEntries lists existing log entries for the specified incident.
 func (c *Client) ListIncidentLogEntries(id string, o ListIncidentLogEntriesOptions) (*ListIncidentLogEntriesResponse, error) {
+	return c.ListIncidentLogEntriesContext(context.Background(), id, o)
+}
+
+// ListIncidentLogEntriesContext lists existing log entries for the specified
+// incident, observing the cancellation and deadline of ctx.
+func (c *Client) ListIncidentLogEntriesContext(ctx context.Context, id string, o ListIncidentLogEntriesOptions) (*ListIncidentLogEntriesResponse, error) {
 	v, err := query.Values(o)
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.get("/incidents/" + id + "/log_entries?" + v.Encode())
+	resp, err := c.getContext(ctx, "/incidents/"+id+"/log_entries?"+v.Encode())
 	if err != nil {
 		return nil, err
 	}
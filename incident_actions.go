@@ -0,0 +1,106 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+// incidentUpdate is the per-incident payload PagerDuty expects in the body
+// of a PUT /incidents request.
+type incidentUpdate struct {
+	ID              string             `json:"id"`
+	Type            string             `json:"type"`
+	Status          string             `json:"status,omitempty"`
+	EscalationLevel uint               `json:"escalation_level,omitempty"`
+	Priority        *APIReference      `json:"priority,omitempty"`
+	Assignments     []assignmentUpdate `json:"assignments,omitempty"`
+	Resolution      string             `json:"resolution,omitempty"`
+}
+
+// assignmentUpdate is the per-assignee payload PagerDuty expects when
+// reassigning an incident.
+type assignmentUpdate struct {
+	Assignee APIReference `json:"assignee"`
+}
+
+// putIncidentUpdates issues the PUT /incidents request shared by
+// ManageIncidents and the single-purpose incident lifecycle helpers below,
+// returning the incidents as updated by the API.
+func (c *Client) putIncidentUpdates(ctx context.Context, from string, body interface{}) ([]Incident, error) {
+	headers := map[string]string{"From": from}
+	resp, err := c.putContext(ctx, "/incidents", body, &headers)
+	if err != nil {
+		return nil, err
+	}
+	var result ListIncidentsResponse
+	if err := c.decodeJSON(resp, &result); err != nil {
+		return nil, err
+	}
+	return result.Incidents, nil
+}
+
+func (c *Client) manageIncidentUpdates(ctx context.Context, from string, updates []incidentUpdate) ([]Incident, error) {
+	return c.putIncidentUpdates(ctx, from, map[string][]incidentUpdate{"incidents": updates})
+}
+
+// AcknowledgeIncidents acknowledges the incidents with the given ids and
+// returns them as updated by the API.
+func (c *Client) AcknowledgeIncidents(ctx context.Context, from string, ids ...string) ([]Incident, error) {
+	updates := make([]incidentUpdate, len(ids))
+	for i, id := range ids {
+		updates[i] = incidentUpdate{ID: id, Type: "incident_reference", Status: "acknowledged"}
+	}
+	return c.manageIncidentUpdates(ctx, from, updates)
+}
+
+// ResolveIncidents resolves the incidents with the given ids, recording
+// resolution as the resolution note, and returns them as updated by the API.
+func (c *Client) ResolveIncidents(ctx context.Context, from string, resolution string, ids ...string) ([]Incident, error) {
+	updates := make([]incidentUpdate, len(ids))
+	for i, id := range ids {
+		updates[i] = incidentUpdate{ID: id, Type: "incident_reference", Status: "resolved", Resolution: resolution}
+	}
+	return c.manageIncidentUpdates(ctx, from, updates)
+}
+
+// ReassignIncidents reassigns the incidents with the given ids to the users
+// identified by assigneeIDs and returns them as updated by the API.
+func (c *Client) ReassignIncidents(ctx context.Context, from string, ids []string, assigneeIDs []string) ([]Incident, error) {
+	assignments := make([]assignmentUpdate, len(assigneeIDs))
+	for i, id := range assigneeIDs {
+		assignments[i] = assignmentUpdate{Assignee: APIReference{ID: id, Type: "user_reference"}}
+	}
+	updates := make([]incidentUpdate, len(ids))
+	for i, id := range ids {
+		updates[i] = incidentUpdate{ID: id, Type: "incident_reference", Assignments: assignments}
+	}
+	return c.manageIncidentUpdates(ctx, from, updates)
+}
+
+// EscalateIncidents escalates the incidents with the given ids to the given
+// escalation level and returns them as updated by the API.
+func (c *Client) EscalateIncidents(ctx context.Context, from string, ids []string, level uint) ([]Incident, error) {
+	updates := make([]incidentUpdate, len(ids))
+	for i, id := range ids {
+		updates[i] = incidentUpdate{ID: id, Type: "incident_reference", EscalationLevel: level}
+	}
+	return c.manageIncidentUpdates(ctx, from, updates)
+}
+
+// SetPriority sets the priority of the incident id to priorityID and returns
+// it as updated by the API.
+func (c *Client) SetPriority(ctx context.Context, from, id, priorityID string) (*Incident, error) {
+	updates := []incidentUpdate{{
+		ID:       id,
+		Type:     "incident_reference",
+		Priority: &APIReference{ID: priorityID, Type: "priority_reference"},
+	}}
+	incidents, err := c.manageIncidentUpdates(ctx, from, updates)
+	if err != nil {
+		return nil, err
+	}
+	if len(incidents) == 0 {
+		return nil, fmt.Errorf("JSON response did not contain the updated incident")
+	}
+	return &incidents[0], nil
+}
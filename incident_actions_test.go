@@ -0,0 +1,177 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupIncidentActionsTest(t *testing.T) (*http.ServeMux, *Client, func()) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	client := NewClient("test-token")
+	client.apiEndpoint = server.URL
+	return mux, client, server.Close
+}
+
+func TestAcknowledgeIncidents_SendsStatusAndReturnsUpdated(t *testing.T) {
+	mux, client, teardown := setupIncidentActionsTest(t)
+	defer teardown()
+
+	var gotBody map[string][]incidentUpdate
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Fatalf("got method %s, want PUT", r.Method)
+		}
+		if got := r.Header.Get("From"); got != "user@example.com" {
+			t.Fatalf("From header = %q, want user@example.com", got)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		resp := ListIncidentsResponse{Incidents: []Incident{{Id: "INC1", Status: "acknowledged"}}}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	incidents, err := client.AcknowledgeIncidents(context.Background(), "user@example.com", "INC1")
+	if err != nil {
+		t.Fatalf("AcknowledgeIncidents returned error: %v", err)
+	}
+
+	updates := gotBody["incidents"]
+	if len(updates) != 1 || updates[0].ID != "INC1" || updates[0].Status != "acknowledged" {
+		t.Fatalf("got request body %+v, want a single acknowledged update for INC1", updates)
+	}
+
+	if len(incidents) != 1 || incidents[0].Id != "INC1" || incidents[0].Status != "acknowledged" {
+		t.Fatalf("got %+v, want the updated incident", incidents)
+	}
+}
+
+func TestResolveIncidents_SendsResolution(t *testing.T) {
+	mux, client, teardown := setupIncidentActionsTest(t)
+	defer teardown()
+
+	var gotBody map[string][]incidentUpdate
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		resp := ListIncidentsResponse{Incidents: []Incident{{Id: "INC1", Status: "resolved"}}}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if _, err := client.ResolveIncidents(context.Background(), "user@example.com", "fixed by restart", "INC1"); err != nil {
+		t.Fatalf("ResolveIncidents returned error: %v", err)
+	}
+
+	updates := gotBody["incidents"]
+	if len(updates) != 1 || updates[0].Status != "resolved" || updates[0].Resolution != "fixed by restart" {
+		t.Fatalf("got request body %+v, want a single resolved update carrying the resolution", updates)
+	}
+}
+
+func TestReassignIncidents_SendsAssignments(t *testing.T) {
+	mux, client, teardown := setupIncidentActionsTest(t)
+	defer teardown()
+
+	var gotBody map[string][]incidentUpdate
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		resp := ListIncidentsResponse{Incidents: []Incident{{Id: "INC1"}}}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if _, err := client.ReassignIncidents(context.Background(), "user@example.com", []string{"INC1"}, []string{"USR1", "USR2"}); err != nil {
+		t.Fatalf("ReassignIncidents returned error: %v", err)
+	}
+
+	updates := gotBody["incidents"]
+	if len(updates) != 1 || len(updates[0].Assignments) != 2 {
+		t.Fatalf("got request body %+v, want one update with two assignments", updates)
+	}
+	if updates[0].Assignments[0].Assignee.ID != "USR1" || updates[0].Assignments[1].Assignee.ID != "USR2" {
+		t.Fatalf("got assignees %+v, want USR1 then USR2", updates[0].Assignments)
+	}
+}
+
+func TestEscalateIncidents_SendsEscalationLevel(t *testing.T) {
+	mux, client, teardown := setupIncidentActionsTest(t)
+	defer teardown()
+
+	var gotBody map[string][]incidentUpdate
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		resp := ListIncidentsResponse{Incidents: []Incident{{Id: "INC1"}}}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if _, err := client.EscalateIncidents(context.Background(), "user@example.com", []string{"INC1"}, 2); err != nil {
+		t.Fatalf("EscalateIncidents returned error: %v", err)
+	}
+
+	updates := gotBody["incidents"]
+	if len(updates) != 1 || updates[0].EscalationLevel != 2 {
+		t.Fatalf("got request body %+v, want a single update with escalation_level 2", updates)
+	}
+}
+
+func TestSetPriority_ReturnsUpdatedIncident(t *testing.T) {
+	mux, client, teardown := setupIncidentActionsTest(t)
+	defer teardown()
+
+	var gotBody map[string][]incidentUpdate
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		resp := ListIncidentsResponse{Incidents: []Incident{{Id: "INC1", Priority: &APIObject{ID: "PRI1"}}}}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	incident, err := client.SetPriority(context.Background(), "user@example.com", "INC1", "PRI1")
+	if err != nil {
+		t.Fatalf("SetPriority returned error: %v", err)
+	}
+
+	updates := gotBody["incidents"]
+	if len(updates) != 1 || updates[0].Priority == nil || updates[0].Priority.ID != "PRI1" {
+		t.Fatalf("got request body %+v, want a single update referencing priority PRI1", updates)
+	}
+	if incident.Id != "INC1" {
+		t.Fatalf("got incident %+v, want the updated incident", incident)
+	}
+}
+
+func TestSetPriority_ErrorsWhenAPIReturnsNoIncidents(t *testing.T) {
+	mux, client, teardown := setupIncidentActionsTest(t)
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		resp := ListIncidentsResponse{}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if _, err := client.SetPriority(context.Background(), "user@example.com", "INC1", "PRI1"); err == nil {
+		t.Fatal("SetPriority returned no error for an empty incidents response")
+	}
+}
@@ -0,0 +1,367 @@
+package pagerduty
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// incidentPagePrefetchDepth bounds how many fetched pages an iterator buffers
+// ahead of the page the caller is currently consuming. The producer goroutine
+// may hold one further page that it has fetched and is waiting to buffer.
+const incidentPagePrefetchDepth = 1
+
+// Note on context: the ctx passed to the first call to Next governs the
+// iterator's producer goroutine for its entire lifetime, including fetches
+// triggered by later calls to Next. Passing a different ctx to a later Next
+// call only affects that call's wait on the next already-in-flight page; it
+// does not cancel or otherwise affect the producer's HTTP requests. Callers
+// that need per-fetch cancellation should pass the same, long-lived ctx to
+// every call. Callers that stop consuming before reaching io.EOF must call
+// Close to let the producer goroutine exit.
+
+type incidentPage struct {
+	incidents []Incident
+	err       error
+}
+
+// IncidentIterator walks the pages returned by ListIncidents, transparently
+// issuing follow-up requests as the offset advances. It buffers up to
+// incidentPagePrefetchDepth pages ahead of the one currently being consumed,
+// plus one page the producer has fetched and is waiting to buffer.
+type IncidentIterator struct {
+	c    *Client
+	opts ListIncidentsOptions
+
+	once  sync.Once
+	pages chan incidentPage
+	done  chan struct{}
+
+	cur []Incident
+	err error
+}
+
+// IterateIncidents returns an iterator over every incident matching o,
+// fetching pages from the API as needed. Call Next repeatedly until it
+// returns io.EOF, or call Close if you stop before then.
+func (c *Client) IterateIncidents(o ListIncidentsOptions) *IncidentIterator {
+	return &IncidentIterator{
+		c:     c,
+		opts:  o,
+		pages: make(chan incidentPage, incidentPagePrefetchDepth),
+		done:  make(chan struct{}),
+	}
+}
+
+func (it *IncidentIterator) start(ctx context.Context) {
+	go func() {
+		defer close(it.pages)
+		opts := it.opts
+		for {
+			resp, err := it.c.ListIncidentsContext(ctx, opts)
+			if err != nil {
+				select {
+				case it.pages <- incidentPage{err: err}:
+				case <-it.done:
+				}
+				return
+			}
+			select {
+			case it.pages <- incidentPage{incidents: resp.Incidents}:
+			case <-it.done:
+				return
+			}
+			if !resp.More || len(resp.Incidents) == 0 {
+				return
+			}
+			opts.Offset = resp.Offset + uint(len(resp.Incidents))
+		}
+	}()
+}
+
+// Next returns the next incident, fetching additional pages from the API as
+// needed. It returns io.EOF once every incident matching the iterator's
+// options has been returned, or ctx.Err() if ctx is done first. See the
+// package-level note on IncidentIterator for which ctx governs the fetches
+// themselves.
+func (it *IncidentIterator) Next(ctx context.Context) (Incident, error) {
+	it.once.Do(func() { it.start(ctx) })
+
+	for len(it.cur) == 0 {
+		if it.err != nil {
+			return Incident{}, it.err
+		}
+		select {
+		case <-ctx.Done():
+			return Incident{}, ctx.Err()
+		case <-it.done:
+			return Incident{}, io.EOF
+		case page, ok := <-it.pages:
+			if !ok {
+				return Incident{}, io.EOF
+			}
+			if page.err != nil {
+				it.err = page.err
+				return Incident{}, page.err
+			}
+			it.cur = page.incidents
+		}
+	}
+
+	i := it.cur[0]
+	it.cur = it.cur[1:]
+	return i, nil
+}
+
+// Close signals the iterator's producer goroutine to stop fetching further
+// pages. Callers that abandon an iterator before Next returns io.EOF must
+// call Close to avoid leaking that goroutine. Close is safe to call more
+// than once and safe to call even if Next was never called.
+func (it *IncidentIterator) Close() {
+	it.once.Do(func() {})
+	select {
+	case <-it.done:
+	default:
+		close(it.done)
+	}
+}
+
+// ListAllIncidents collects every incident matching o across all pages.
+func (c *Client) ListAllIncidents(o ListIncidentsOptions) ([]Incident, error) {
+	ctx := context.Background()
+	it := c.IterateIncidents(o)
+	defer it.Close()
+	var all []Incident
+	for {
+		i, err := it.Next(ctx)
+		if err == io.EOF {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, i)
+	}
+}
+
+type logEntryPage struct {
+	entries []LogEntry
+	err     error
+}
+
+// IncidentLogEntryIterator walks the pages returned by
+// ListIncidentLogEntries for a single incident, buffering up to
+// incidentPagePrefetchDepth pages ahead plus one page the producer has
+// fetched and is waiting to buffer.
+type IncidentLogEntryIterator struct {
+	c    *Client
+	id   string
+	opts ListIncidentLogEntriesOptions
+
+	once  sync.Once
+	pages chan logEntryPage
+	done  chan struct{}
+
+	cur []LogEntry
+	err error
+}
+
+// IterateIncidentLogEntries returns an iterator over every log entry for the
+// incident id matching o. Call Next repeatedly until it returns io.EOF, or
+// call Close if you stop before then.
+func (c *Client) IterateIncidentLogEntries(id string, o ListIncidentLogEntriesOptions) *IncidentLogEntryIterator {
+	return &IncidentLogEntryIterator{
+		c:     c,
+		id:    id,
+		opts:  o,
+		pages: make(chan logEntryPage, incidentPagePrefetchDepth),
+		done:  make(chan struct{}),
+	}
+}
+
+func (it *IncidentLogEntryIterator) start(ctx context.Context) {
+	go func() {
+		defer close(it.pages)
+		opts := it.opts
+		for {
+			resp, err := it.c.ListIncidentLogEntriesContext(ctx, it.id, opts)
+			if err != nil {
+				select {
+				case it.pages <- logEntryPage{err: err}:
+				case <-it.done:
+				}
+				return
+			}
+			select {
+			case it.pages <- logEntryPage{entries: resp.LogEntries}:
+			case <-it.done:
+				return
+			}
+			if !resp.More || len(resp.LogEntries) == 0 {
+				return
+			}
+			opts.Offset = resp.Offset + uint(len(resp.LogEntries))
+		}
+	}()
+}
+
+// Next returns the next log entry, fetching additional pages from the API as
+// needed. It returns io.EOF once every log entry has been returned, or
+// ctx.Err() if ctx is done first. See the package-level note on
+// IncidentIterator for which ctx governs the fetches themselves.
+func (it *IncidentLogEntryIterator) Next(ctx context.Context) (LogEntry, error) {
+	it.once.Do(func() { it.start(ctx) })
+
+	for len(it.cur) == 0 {
+		if it.err != nil {
+			return LogEntry{}, it.err
+		}
+		select {
+		case <-ctx.Done():
+			return LogEntry{}, ctx.Err()
+		case <-it.done:
+			return LogEntry{}, io.EOF
+		case page, ok := <-it.pages:
+			if !ok {
+				return LogEntry{}, io.EOF
+			}
+			if page.err != nil {
+				it.err = page.err
+				return LogEntry{}, page.err
+			}
+			it.cur = page.entries
+		}
+	}
+
+	e := it.cur[0]
+	it.cur = it.cur[1:]
+	return e, nil
+}
+
+// Close signals the iterator's producer goroutine to stop fetching further
+// pages. Callers that abandon an iterator before Next returns io.EOF must
+// call Close to avoid leaking that goroutine. Close is safe to call more
+// than once and safe to call even if Next was never called.
+func (it *IncidentLogEntryIterator) Close() {
+	it.once.Do(func() {})
+	select {
+	case <-it.done:
+	default:
+		close(it.done)
+	}
+}
+
+// ListAllIncidentLogEntries collects every log entry for the incident id
+// matching o across all pages.
+func (c *Client) ListAllIncidentLogEntries(id string, o ListIncidentLogEntriesOptions) ([]LogEntry, error) {
+	ctx := context.Background()
+	it := c.IterateIncidentLogEntries(id, o)
+	defer it.Close()
+	var all []LogEntry
+	for {
+		e, err := it.Next(ctx)
+		if err == io.EOF {
+			return all, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, e)
+	}
+}
+
+// IncidentNoteIterator walks the notes for a single incident. The notes
+// endpoint is not paginated, so the iterator fetches once and then drains
+// the result it already has.
+type IncidentNoteIterator struct {
+	c  *Client
+	id string
+
+	once  sync.Once
+	fetch chan incidentNoteFetch
+	done  chan struct{}
+
+	cur []IncidentNote
+	err error
+}
+
+type incidentNoteFetch struct {
+	notes []IncidentNote
+	err   error
+}
+
+// IterateIncidentNotes returns an iterator over every note on the incident
+// id. Call Next repeatedly until it returns io.EOF, or call Close if you
+// stop before then.
+func (c *Client) IterateIncidentNotes(id string) *IncidentNoteIterator {
+	return &IncidentNoteIterator{
+		c:     c,
+		id:    id,
+		fetch: make(chan incidentNoteFetch, 1),
+		done:  make(chan struct{}),
+	}
+}
+
+func (it *IncidentNoteIterator) start(ctx context.Context) {
+	go func() {
+		defer close(it.fetch)
+		notes, err := it.c.ListIncidentNotesContext(ctx, it.id)
+		select {
+		case it.fetch <- incidentNoteFetch{notes: notes, err: err}:
+		case <-it.done:
+		}
+	}()
+}
+
+// Next returns the next note, or io.EOF once every note has been returned,
+// or ctx.Err() if ctx is done first. See the package-level note on
+// IncidentIterator for which ctx governs the fetch itself.
+func (it *IncidentNoteIterator) Next(ctx context.Context) (IncidentNote, error) {
+	it.once.Do(func() { it.start(ctx) })
+
+	for len(it.cur) == 0 {
+		if it.err != nil {
+			return IncidentNote{}, it.err
+		}
+		if it.fetch == nil {
+			return IncidentNote{}, io.EOF
+		}
+		select {
+		case <-ctx.Done():
+			return IncidentNote{}, ctx.Err()
+		case <-it.done:
+			return IncidentNote{}, io.EOF
+		case f, ok := <-it.fetch:
+			it.fetch = nil
+			if !ok {
+				return IncidentNote{}, io.EOF
+			}
+			if f.err != nil {
+				it.err = f.err
+				return IncidentNote{}, f.err
+			}
+			it.cur = f.notes
+		}
+	}
+
+	n := it.cur[0]
+	it.cur = it.cur[1:]
+	return n, nil
+}
+
+// Close signals the iterator's producer goroutine to stop, in case it is
+// still waiting to deliver its single fetched page. Close is safe to call
+// more than once and safe to call even if Next was never called.
+func (it *IncidentNoteIterator) Close() {
+	it.once.Do(func() {})
+	select {
+	case <-it.done:
+	default:
+		close(it.done)
+	}
+}
+
+// ListAllIncidentNotes returns every note on the incident id.
+func (c *Client) ListAllIncidentNotes(id string) ([]IncidentNote, error) {
+	return c.ListIncidentNotesContext(context.Background(), id)
+}
@@ -0,0 +1,129 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func setupIncidentIteratorTest(t *testing.T) (*http.ServeMux, *Client, func()) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	client := NewClient("test-token")
+	client.apiEndpoint = server.URL
+	return mux, client, server.Close
+}
+
+func TestIncidentIterator_NextExhaustsAllPages(t *testing.T) {
+	mux, client, teardown := setupIncidentIteratorTest(t)
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		var resp ListIncidentsResponse
+		switch offset {
+		case "", "0":
+			resp = ListIncidentsResponse{
+				APIListObject: APIListObject{Limit: 1, Offset: 0, More: true},
+				Incidents:     []Incident{{Id: "INC1"}},
+			}
+		case "1":
+			resp = ListIncidentsResponse{
+				APIListObject: APIListObject{Limit: 1, Offset: 1, More: false},
+				Incidents:     []Incident{{Id: "INC2"}},
+			}
+		default:
+			t.Fatalf("unexpected offset %q", offset)
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	it := client.IterateIncidents(ListIncidentsOptions{})
+	defer it.Close()
+
+	var got []string
+	ctx := context.Background()
+	for {
+		i, err := it.Next(ctx)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next returned error: %v", err)
+		}
+		got = append(got, i.Id)
+	}
+
+	want := []string{"INC1", "INC2"}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+}
+
+func TestIncidentIterator_NextReturnsCtxErrOnCancellation(t *testing.T) {
+	mux, client, teardown := setupIncidentIteratorTest(t)
+	defer teardown()
+
+	block := make(chan struct{})
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		<-block
+	})
+	defer close(block)
+
+	it := client.IterateIncidents(ListIncidentsOptions{})
+	defer it.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := it.Next(ctx)
+	if err != context.Canceled {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+}
+
+func TestIncidentIterator_CloseStopsProducerWithoutLeaking(t *testing.T) {
+	mux, client, teardown := setupIncidentIteratorTest(t)
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		resp := ListIncidentsResponse{
+			APIListObject: APIListObject{Limit: 1, Offset: 0, More: true},
+			Incidents:     []Incident{{Id: "INC1"}},
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	it := client.IterateIncidents(ListIncidentsOptions{})
+
+	ctx := context.Background()
+	if _, err := it.Next(ctx); err != nil {
+		t.Fatalf("Next returned error: %v", err)
+	}
+	it.Close()
+
+	// After Close, the producer goroutine must exit and close it.pages
+	// instead of leaking on a blocked send, so a further Next returns
+	// promptly (io.EOF, or an incident from a page buffered before Close)
+	// rather than hanging.
+	done := make(chan struct{})
+	go func() {
+		it.Next(ctx)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Next blocked after Close instead of returning")
+	}
+}
@@ -0,0 +1,120 @@
+package pagerduty
+
+import (
+	"context"
+	"fmt"
+)
+
+type mergeIncidentsRequest struct {
+	SourceIncidents []APIReference `json:"source_incidents"`
+}
+
+// MergeIncidents merges the incidents identified by sourceIDs into the
+// incident targetID and returns the merged incident.
+func (c *Client) MergeIncidents(ctx context.Context, from, targetID string, sourceIDs []string) (*Incident, error) {
+	sources := make([]APIReference, len(sourceIDs))
+	for i, id := range sourceIDs {
+		sources[i] = APIReference{ID: id, Type: "incident_reference"}
+	}
+	headers := map[string]string{"From": from}
+	resp, err := c.postContext(ctx, "/incidents/"+targetID+"/merge", mergeIncidentsRequest{SourceIncidents: sources}, &headers)
+	if err != nil {
+		return nil, err
+	}
+	var result map[string]Incident
+	if err := c.decodeJSON(resp, &result); err != nil {
+		return nil, err
+	}
+	i, ok := result["incident"]
+	if !ok {
+		return nil, fmt.Errorf("JSON response does not have incident field")
+	}
+	return &i, nil
+}
+
+// StatusUpdate is a free-form status update posted to an incident's
+// stakeholder timeline.
+type StatusUpdate struct {
+	ID        string    `json:"id,omitempty"`
+	Type      string    `json:"type,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	CreatedAt string    `json:"created_at,omitempty"`
+	Sender    APIObject `json:"sender,omitempty"`
+}
+
+type createStatusUpdateRequest struct {
+	Message string `json:"message"`
+}
+
+// CreateStatusUpdate posts a status update with the given message to the
+// incident id's stakeholder timeline.
+func (c *Client) CreateStatusUpdate(ctx context.Context, from, id, message string) (*StatusUpdate, error) {
+	headers := map[string]string{"From": from}
+	resp, err := c.postContext(ctx, "/incidents/"+id+"/status_updates", createStatusUpdateRequest{Message: message}, &headers)
+	if err != nil {
+		return nil, err
+	}
+	var result StatusUpdate
+	return &result, c.decodeJSON(resp, &result)
+}
+
+// ResponderRequestTarget identifies a user or escalation policy that a
+// responder request asks to respond to an incident.
+type ResponderRequestTarget struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// ResponderRequest is the payload used to request additional responders for
+// an incident.
+type ResponderRequest struct {
+	RequesterID string                   `json:"requester_id"`
+	Message     string                   `json:"message"`
+	Targets     []ResponderRequestTarget `json:"responder_request_targets"`
+}
+
+// ResponderRequestResponse is returned by CreateResponderRequest.
+type ResponderRequestResponse struct {
+	ResponderRequest ResponderRequest `json:"responder_request"`
+	Incident         Incident         `json:"incident"`
+}
+
+// CreateResponderRequest asks the users or escalation policies named in req
+// to respond to the incident id.
+func (c *Client) CreateResponderRequest(ctx context.Context, from, id string, req ResponderRequest) (*ResponderRequestResponse, error) {
+	headers := map[string]string{"From": from}
+	data := map[string]ResponderRequest{"responder_request": req}
+	resp, err := c.postContext(ctx, "/incidents/"+id+"/responder_requests", data, &headers)
+	if err != nil {
+		return nil, err
+	}
+	var result ResponderRequestResponse
+	return &result, c.decodeJSON(resp, &result)
+}
+
+// RelatedIncident describes an incident PagerDuty considers related to the
+// one queried, along with why.
+type RelatedIncident struct {
+	Incident             Incident               `json:"incident"`
+	RelationshipType     string                 `json:"relationship_type,omitempty"`
+	RelationshipMetadata map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type listRelatedIncidentsResponse struct {
+	RelatedIncidents []RelatedIncident `json:"related_incidents"`
+}
+
+// ListRelatedIncidents lists incidents PagerDuty considers related to the
+// incident id, e.g. because they affect the same service or share a
+// timeframe.
+func (c *Client) ListRelatedIncidents(ctx context.Context, id string) ([]RelatedIncident, error) {
+	resp, err := c.getContext(ctx, "/incidents/"+id+"/related_incidents")
+	if err != nil {
+		return nil, err
+	}
+	var result listRelatedIncidentsResponse
+	if err := c.decodeJSON(resp, &result); err != nil {
+		return nil, err
+	}
+	return result.RelatedIncidents, nil
+}
@@ -0,0 +1,157 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupIncidentRelatedTest(t *testing.T) (*http.ServeMux, *Client, func()) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	client := NewClient("test-token")
+	client.apiEndpoint = server.URL
+	return mux, client, server.Close
+}
+
+func TestMergeIncidents_SendsSourcesAndReturnsMerged(t *testing.T) {
+	mux, client, teardown := setupIncidentRelatedTest(t)
+	defer teardown()
+
+	var gotBody mergeIncidentsRequest
+	mux.HandleFunc("/incidents/INC1/merge", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("From"); got != "user@example.com" {
+			t.Fatalf("From header = %q, want user@example.com", got)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		result := map[string]Incident{"incident": {Id: "INC1"}}
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	incident, err := client.MergeIncidents(context.Background(), "user@example.com", "INC1", []string{"INC2", "INC3"})
+	if err != nil {
+		t.Fatalf("MergeIncidents returned error: %v", err)
+	}
+
+	if len(gotBody.SourceIncidents) != 2 || gotBody.SourceIncidents[0].ID != "INC2" || gotBody.SourceIncidents[1].ID != "INC3" {
+		t.Fatalf("got source_incidents %+v, want INC2 then INC3", gotBody.SourceIncidents)
+	}
+	if incident.Id != "INC1" {
+		t.Fatalf("got incident %+v, want the merged incident INC1", incident)
+	}
+}
+
+func TestMergeIncidents_ErrorsWhenResponseHasNoIncidentField(t *testing.T) {
+	mux, client, teardown := setupIncidentRelatedTest(t)
+	defer teardown()
+
+	mux.HandleFunc("/incidents/INC1/merge", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(map[string]string{}); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if _, err := client.MergeIncidents(context.Background(), "user@example.com", "INC1", []string{"INC2"}); err == nil {
+		t.Fatal("MergeIncidents returned no error for a response missing the incident field")
+	}
+}
+
+func TestCreateStatusUpdate_SendsMessageAndReturnsUpdate(t *testing.T) {
+	mux, client, teardown := setupIncidentRelatedTest(t)
+	defer teardown()
+
+	var gotBody createStatusUpdateRequest
+	mux.HandleFunc("/incidents/INC1/status_updates", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("From"); got != "user@example.com" {
+			t.Fatalf("From header = %q, want user@example.com", got)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		result := StatusUpdate{ID: "SU1", Message: "investigating"}
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	update, err := client.CreateStatusUpdate(context.Background(), "user@example.com", "INC1", "investigating")
+	if err != nil {
+		t.Fatalf("CreateStatusUpdate returned error: %v", err)
+	}
+
+	if gotBody.Message != "investigating" {
+		t.Fatalf("got request body %+v, want message 'investigating'", gotBody)
+	}
+	if update.ID != "SU1" || update.Message != "investigating" {
+		t.Fatalf("got %+v, want the created status update", update)
+	}
+}
+
+func TestCreateResponderRequest_SendsTargetsAndReturnsResponse(t *testing.T) {
+	mux, client, teardown := setupIncidentRelatedTest(t)
+	defer teardown()
+
+	var gotBody map[string]ResponderRequest
+	mux.HandleFunc("/incidents/INC1/responder_requests", func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&gotBody); err != nil {
+			t.Fatal(err)
+		}
+		result := ResponderRequestResponse{
+			ResponderRequest: gotBody["responder_request"],
+			Incident:         Incident{Id: "INC1"},
+		}
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	req := ResponderRequest{
+		RequesterID: "USR1",
+		Message:     "need a hand",
+		Targets:     []ResponderRequestTarget{{ID: "USR2", Type: "user_reference"}},
+	}
+	resp, err := client.CreateResponderRequest(context.Background(), "user@example.com", "INC1", req)
+	if err != nil {
+		t.Fatalf("CreateResponderRequest returned error: %v", err)
+	}
+
+	sent := gotBody["responder_request"]
+	if sent.RequesterID != "USR1" || len(sent.Targets) != 1 || sent.Targets[0].ID != "USR2" {
+		t.Fatalf("got request body %+v, want requester USR1 targeting USR2", sent)
+	}
+	if resp.Incident.Id != "INC1" {
+		t.Fatalf("got response %+v, want it to carry incident INC1", resp)
+	}
+}
+
+func TestListRelatedIncidents_ReturnsRelatedIncidents(t *testing.T) {
+	mux, client, teardown := setupIncidentRelatedTest(t)
+	defer teardown()
+
+	mux.HandleFunc("/incidents/INC1/related_incidents", func(w http.ResponseWriter, r *http.Request) {
+		result := listRelatedIncidentsResponse{
+			RelatedIncidents: []RelatedIncident{
+				{Incident: Incident{Id: "INC2"}, RelationshipType: "machine_learning_inferred"},
+			},
+		}
+		if err := json.NewEncoder(w).Encode(result); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	related, err := client.ListRelatedIncidents(context.Background(), "INC1")
+	if err != nil {
+		t.Fatalf("ListRelatedIncidents returned error: %v", err)
+	}
+
+	if len(related) != 1 || related[0].Incident.Id != "INC2" || related[0].RelationshipType != "machine_learning_inferred" {
+		t.Fatalf("got %+v, want a single related incident INC2", related)
+	}
+}
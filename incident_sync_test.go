@@ -0,0 +1,98 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func setupIncidentSyncTest(t *testing.T) (*http.ServeMux, *Client, func()) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	client := NewClient("test-token")
+	client.apiEndpoint = server.URL
+	return mux, client, server.Close
+}
+
+func TestIncidentSyncer_SyncBacksOffOn429AndAdvancesWatermark(t *testing.T) {
+	mux, client, teardown := setupIncidentSyncTest(t)
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		resp := ListIncidentsResponse{
+			APIListObject: APIListObject{Limit: 100, Offset: 0, More: false},
+			Incidents: []Incident{
+				{Id: "INC1", CreatedAt: "2026-01-01T00:00:00Z", LastStatusChangeAt: "2026-01-01T00:00:00Z"},
+				{Id: "INC2", CreatedAt: "2026-01-02T00:00:00Z", LastStatusChangeAt: "2026-01-03T00:00:00Z"},
+			},
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	syncer := NewIncidentSyncer(client, IncidentSyncerOptions{ServiceIDs: []string{"SVC1"}})
+
+	var seen []string
+	err := syncer.Sync(context.Background(), func(i Incident) error {
+		seen = append(seen, i.Id)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Sync returned error: %v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("got %d requests, want 2 (one 429 retry then a success)", requests)
+	}
+
+	wantSeen := []string{"INC1", "INC2"}
+	if len(seen) != len(wantSeen) || seen[0] != wantSeen[0] || seen[1] != wantSeen[1] {
+		t.Fatalf("got %v, want %v", seen, wantSeen)
+	}
+
+	wantSince := "2026-01-03T00:00:00Z"
+	if got := syncer.State().Since; got != wantSince {
+		t.Fatalf("State().Since = %q, want %q", got, wantSince)
+	}
+}
+
+func TestIncidentSyncer_SyncStopsOnCallbackError(t *testing.T) {
+	mux, client, teardown := setupIncidentSyncTest(t)
+	defer teardown()
+
+	mux.HandleFunc("/incidents", func(w http.ResponseWriter, r *http.Request) {
+		resp := ListIncidentsResponse{
+			APIListObject: APIListObject{Limit: 100, Offset: 0, More: false},
+			Incidents: []Incident{
+				{Id: "INC1", CreatedAt: "2026-01-01T00:00:00Z"},
+			},
+		}
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	syncer := NewIncidentSyncer(client, IncidentSyncerOptions{ServiceIDs: []string{"SVC1"}})
+
+	wantErr := context.Canceled
+	err := syncer.Sync(context.Background(), func(i Incident) error {
+		return wantErr
+	})
+	if err != wantErr {
+		t.Fatalf("got %v, want %v", err, wantErr)
+	}
+	if syncer.State().Since != "" {
+		t.Fatalf("State().Since = %q, want unchanged empty watermark after a failed Sync", syncer.State().Since)
+	}
+}